@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the YAML binding configuration consumed by graphql-gen, analogous to gqlgen's
+// gqlgen.yml: it tells the generator which schema files to read, where to write the generated
+// executable schema, and which GraphQL types it should generate resolvers for.
+type Config struct {
+	Schema  []string               `yaml:"schema"`
+	Exec    ExecConfig             `yaml:"exec"`
+	Package string                 `yaml:"package"`
+	Models  map[string]ModelConfig `yaml:"models"`
+}
+
+// ExecConfig names the file the generated executable schema is written to.
+type ExecConfig struct {
+	Filename string `yaml:"filename"`
+}
+
+// ModelConfig declares that a GraphQL type is handled by graphql-gen and carries any per-field
+// overrides for it. This first version of graphql-gen generates its own resolver interface for a
+// root operation type rather than binding to a user-supplied Go type, so there is no model type to
+// configure here yet; the entry's presence in Models is itself what opts a root type in.
+type ModelConfig struct {
+	Fields map[string]FieldConfig `yaml:"fields"`
+}
+
+// FieldConfig overrides how a single field on a bound type is resolved. When FieldName is empty,
+// the generator uses the GraphQL field's own name, title-cased, as the Go method or field name.
+type FieldConfig struct {
+	FieldName string `yaml:"fieldName"`
+}
+
+// LoadConfig reads and parses the binding configuration at path.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+
+	if cfg.Package == "" {
+		cfg.Package = "generated"
+	}
+	if cfg.Exec.Filename == "" {
+		cfg.Exec.Filename = "generated/exec.go"
+	}
+
+	return &cfg, nil
+}
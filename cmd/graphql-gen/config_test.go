@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/internal/schema"
+)
+
+const fieldNameOverrideSDL = `
+schema {
+	query: Query
+}
+
+type Query {
+	userName: String!
+}
+`
+
+// TestGenerate_FieldNameOverride checks that a models.<Type>.fields.<field>.fieldName config entry
+// renames the generated method instead of being silently ignored.
+func TestGenerate_FieldNameOverride(t *testing.T) {
+	s := schema.New()
+	if err := schema.Parse(s, fieldNameOverrideSDL, false); err != nil {
+		t.Fatalf("parsing schema: %v", err)
+	}
+
+	cfg := &Config{
+		Package: "gentest",
+		Models: map[string]ModelConfig{
+			"Query": {
+				Fields: map[string]FieldConfig{
+					"userName": {FieldName: "Username"},
+				},
+			},
+		},
+	}
+
+	src, err := Generate(s, cfg)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if !strings.Contains(string(src), "Username(ctx context.Context)") {
+		t.Errorf("expected the configured fieldName override \"Username\" in the generated QueryResolver, got:\n%s", src)
+	}
+	if strings.Contains(string(src), "UserName(ctx context.Context)") {
+		t.Errorf("expected the default exportedName \"UserName\" to be overridden, got:\n%s", src)
+	}
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// Generate renders the Go source of a generated executable schema for schema, driven by cfg.
+//
+// This first version of graphql-gen is deliberately scoped to schemas whose Query, Mutation and
+// Subscription fields all resolve, once NonNull and List wrappers are stripped, to a scalar or
+// enum: the common "root field returns a leaf value" case that otherwise pays for a full
+// reflect.Value.Call on every request. resolvable.ExecutableSchema grafts a generated schema's
+// Query/Mutation/Subscription trees on whole, in place of the reflective builder, with no per-field
+// mixing of the two — so a root field returning an object, interface or union fails Generate
+// outright rather than falling back to reflection for that field alone. Generating object-graph
+// traversal (and the Go-type-per-GraphQL-type model binding config that would require, mirroring
+// gqlgen's binder) is out of scope for this version; schemas with non-leaf root fields should keep
+// using the reflective builder (pass a plain resolver to graphql.MustParseSchema) until that lands.
+func Generate(schema *types.Schema, cfg *Config) ([]byte, error) {
+	g := &generator{schema: schema, cfg: cfg}
+
+	var ops []rootOp
+	for _, opName := range [...]string{"query", "mutation", "subscription"} {
+		t, ok := schema.RootOperationTypes[opName]
+		if !ok {
+			continue
+		}
+		obj, ok := t.(*types.ObjectTypeDefinition)
+		if !ok {
+			return nil, fmt.Errorf("graphql-gen: root operation type %q is not an object type", t.TypeName())
+		}
+		if _, ok := cfg.Models[obj.Name]; !ok {
+			return nil, fmt.Errorf("graphql-gen: no model bound for root type %q in models config", obj.Name)
+		}
+
+		fields, err := g.rootFields(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, rootOp{
+			GoName:       strings.Title(opName),
+			TypeName:     obj.Name,
+			ResolverName: strings.Title(opName) + "Resolver",
+			Fields:       fields,
+		})
+	}
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("graphql-gen: schema defines no root operation types")
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Package         string
+		Ops             []rootOp
+		HasQuery        bool
+		HasMutation     bool
+		HasSubscription bool
+	}{
+		Package:         cfg.Package,
+		Ops:             ops,
+		HasQuery:        hasOp(ops, "Query"),
+		HasMutation:     hasOp(ops, "Mutation"),
+		HasSubscription: hasOp(ops, "Subscription"),
+	}); err != nil {
+		return nil, fmt.Errorf("graphql-gen: rendering template: %w", err)
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("graphql-gen: formatting generated source: %w\n%s", err, buf.String())
+	}
+	return out, nil
+}
+
+// hasOp reports whether ops contains the root operation named goName ("Query", "Mutation" or
+// "Subscription"), i.e. whether the schema defines that root operation type at all.
+func hasOp(ops []rootOp, goName string) bool {
+	for _, op := range ops {
+		if op.GoName == goName {
+			return true
+		}
+	}
+	return false
+}
+
+type generator struct {
+	schema *types.Schema
+	cfg    *Config
+}
+
+type rootOp struct {
+	GoName       string // "Query", "Mutation" or "Subscription"
+	TypeName     string // GraphQL root type name, e.g. "Query"
+	ResolverName string // generated interface name, e.g. "QueryResolver"
+	Fields       []rootField
+}
+
+type rootField struct {
+	GraphQLName string
+	GoName      string // exported Go method/identifier name
+	GoType      string // Go return type, e.g. "string" or "[]int32"
+	IsList      bool
+	Args        []rootArg
+}
+
+type rootArg struct {
+	GraphQLName string
+	GoName      string
+	GoType      string
+	Decode      string // Go expression, in scope of a `raw interface{}`, yielding (GoType, error)
+}
+
+// rootFields builds the generated-field list for a root object, rejecting any field whose return
+// type isn't a (possibly-list, possibly-non-null) scalar or enum.
+func (g *generator) rootFields(obj *types.ObjectTypeDefinition) ([]rootField, error) {
+	model := g.cfg.Models[obj.Name]
+
+	var out []rootField
+	for _, f := range obj.Fields {
+		goType, err := scalarGoType(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("graphql-gen: field %s.%s: %w", obj.Name, f.Name, err)
+		}
+
+		var args []rootArg
+		for _, a := range f.Arguments {
+			argGoType, err := scalarGoType(a.Type)
+			if err != nil {
+				return nil, fmt.Errorf("graphql-gen: field %s.%s argument %s: %w", obj.Name, f.Name, a.Name.Name, err)
+			}
+			decode, err := decodeExprFor(argGoType)
+			if err != nil {
+				return nil, fmt.Errorf("graphql-gen: field %s.%s argument %s: %w", obj.Name, f.Name, a.Name.Name, err)
+			}
+			args = append(args, rootArg{
+				GraphQLName: a.Name.Name,
+				GoName:      exportedName(a.Name.Name),
+				GoType:      argGoType,
+				Decode:      decode,
+			})
+		}
+
+		goName := exportedName(f.Name)
+		if fc, ok := model.Fields[f.Name]; ok && fc.FieldName != "" {
+			goName = fc.FieldName
+		}
+
+		out = append(out, rootField{
+			GraphQLName: f.Name,
+			GoName:      goName,
+			GoType:      goType,
+			IsList:      strings.HasPrefix(goType, "[]"),
+			Args:        args,
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].GraphQLName < out[j].GraphQLName })
+	return out, nil
+}
+
+// scalarGoType maps a NonNull/List-wrapped scalar or enum type to the Go type graphql-gen emits
+// for it. Built-in scalars map to their conventional Go representation; any other named type
+// (object, interface, union, or a custom scalar with no binding) is rejected, since this version
+// of graphql-gen has no type-binding configuration to consult for those yet.
+func scalarGoType(t types.Type) (string, error) {
+	if nn, ok := t.(*types.NonNull); ok {
+		return scalarGoType(nn.OfType)
+	}
+	if l, ok := t.(*types.List); ok {
+		elem, err := scalarGoType(l.OfType)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	}
+
+	switch t := t.(type) {
+	case *types.ScalarTypeDefinition:
+		switch t.Name {
+		case "Int":
+			return "int32", nil
+		case "Float":
+			return "float64", nil
+		case "String", "ID":
+			return "string", nil
+		case "Boolean":
+			return "bool", nil
+		default:
+			return "", fmt.Errorf("custom scalar %q has no binding (not yet supported by graphql-gen)", t.Name)
+		}
+	case *types.EnumTypeDefinition:
+		return "string", nil
+	default:
+		return "", fmt.Errorf("type %q is not a scalar or enum (not yet supported by graphql-gen)", t.String())
+	}
+}
+
+// coerceFuncFor names the generated.Coerce* helper for a leaf (non-list) Go scalar type.
+func coerceFuncFor(goType string) (string, error) {
+	switch goType {
+	case "int32":
+		return "generated.CoerceInt32", nil
+	case "float64":
+		return "generated.CoerceFloat64", nil
+	case "string":
+		return "generated.CoerceString", nil
+	case "bool":
+		return "generated.CoerceBool", nil
+	default:
+		return "", fmt.Errorf("unsupported argument Go type %q", goType)
+	}
+}
+
+// decodeExprFor renders the Go expression, in scope of a `raw interface{}` holding the
+// already-deserialized argument value, that produces (goType, error).
+func decodeExprFor(goType string) (string, error) {
+	if elem, ok := strings.CutPrefix(goType, "[]"); ok {
+		elemFn, err := coerceFuncFor(elem)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("generated.CoerceSlice(raw, %s)", elemFn), nil
+	}
+	fn, err := coerceFuncFor(goType)
+	if err != nil {
+		return "", err
+	}
+	return fn + "(raw)", nil
+}
+
+// exportedName turns a GraphQL field or argument name into an exported Go identifier, the same
+// way the reflective builder's findMethod/findField match a GraphQL name against a Go one: case
+// and underscores are ignored, so here we simply title-case the first rune. A name that begins
+// with an underscore keeps it, and so yields an unexported identifier.
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+var tmpl = template.Must(template.New("exec").Parse(`// Code generated by graphql-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/graph-gophers/graphql-go/internal/exec/resolvable"
+	"github.com/graph-gophers/graphql-go/internal/exec/resolvable/generated"
+)
+
+// fmt and reflect are only referenced by argument decoders; these keep both imports in use for a
+// schema whose root fields take no arguments.
+var (
+	_ = fmt.Errorf
+	_ = reflect.ValueOf
+)
+
+// ExecutableSchema adapts the per-operation resolvers below into a resolvable.ExecutableSchema.
+// Pass it as the resolver argument to graphql.MustParseSchema in place of a plain reflective
+// resolver to skip resolvable.ApplyResolver's reflective builder for these root fields.
+type ExecutableSchema struct {
+{{- range .Ops}}
+	{{.GoName}}Root {{.ResolverName}}
+{{- end}}
+}
+
+// Query, Mutation and Subscription satisfy resolvable.ExecutableSchema. A root operation the
+// schema doesn't define has no build method to call, so it reports a nil Resolvable, the same
+// value resolvable.ApplyResolver leaves an undefined root operation with.
+func (s *ExecutableSchema) Query() resolvable.Resolvable {
+{{- if .HasQuery}}
+	return s.buildQuery()
+{{- else}}
+	return nil
+{{- end}}
+}
+
+func (s *ExecutableSchema) Mutation() resolvable.Resolvable {
+{{- if .HasMutation}}
+	return s.buildMutation()
+{{- else}}
+	return nil
+{{- end}}
+}
+
+func (s *ExecutableSchema) Subscription() resolvable.Resolvable {
+{{- if .HasSubscription}}
+	return s.buildSubscription()
+{{- else}}
+	return nil
+{{- end}}
+}
+
+{{range $op := .Ops}}
+// {{$op.ResolverName}} is implemented by the application's {{$op.TypeName}} resolver.
+type {{$op.ResolverName}} interface {
+{{- range $op.Fields}}
+	{{.GoName}}(ctx context.Context{{range .Args}}, {{.GoName}} {{.GoType}}{{end}}) ({{.GoType}}, error)
+{{- end}}
+}
+
+func (s *ExecutableSchema) build{{$op.GoName}}() resolvable.Resolvable {
+	fields := make(map[string]*resolvable.Field, {{len $op.Fields}})
+{{range $op.Fields}}
+	fields["{{.GraphQLName}}"] = generated.NewField("{{$op.TypeName}}", "{{.GraphQLName}}",
+{{- if .Args}}
+		generated.StructDecoder{Decode: decode{{$op.GoName}}{{.GoName}}Args},
+{{- else}}
+		nil,
+{{- end}}
+		func(ctx context.Context, resolver interface{}, args interface{}, dirs map[string]interface{}) (interface{}, error) {
+			root := resolver.(*ExecutableSchema).{{$op.GoName}}Root
+{{- if .Args}}
+			a := args.({{$op.GoName}}{{.GoName}}Args)
+			return root.{{.GoName}}(ctx{{range .Args}}, a.{{.GoName}}{{end}})
+{{- else}}
+			return root.{{.GoName}}(ctx)
+{{- end}}
+		},
+{{- if .IsList}}
+		generated.List(generated.Scalar()))
+{{- else}}
+		generated.Scalar())
+{{- end}}
+{{end}}
+	return generated.NewObject("{{$op.TypeName}}", fields, nil)
+}
+{{range .Fields}}
+{{if .Args}}
+// {{$op.GoName}}{{.GoName}}Args holds the arguments for the {{$op.TypeName}}.{{.GraphQLName}} field.
+type {{$op.GoName}}{{.GoName}}Args struct {
+{{- range .Args}}
+	{{.GoName}} {{.GoType}}
+{{- end}}
+}
+
+func decode{{$op.GoName}}{{.GoName}}Args(value interface{}) (reflect.Value, error) {
+	m, _ := value.(map[string]interface{})
+	var a {{$op.GoName}}{{.GoName}}Args
+{{- range .Args}}
+	if raw, ok := m["{{.GraphQLName}}"]; ok {
+		v, err := {{.Decode}}
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("argument %q: %w", "{{.GraphQLName}}", err)
+		}
+		a.{{.GoName}} = v
+	}
+{{- end}}
+	return reflect.ValueOf(a), nil
+}
+{{end}}
+{{end}}
+{{end}}
+`))
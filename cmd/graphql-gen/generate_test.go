@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/internal/schema"
+)
+
+const queryOnlySDL = `
+schema {
+	query: Query
+}
+
+type Query {
+	hello: String!
+}
+`
+
+const objectRootFieldSDL = `
+schema {
+	query: Query
+}
+
+type Query {
+	me: User!
+}
+
+type User {
+	name: String!
+}
+`
+
+// TestGenerate_ObjectRootFieldRejected locks in the documented scope of this version of
+// graphql-gen: a root field returning an object, interface or union fails the whole Generate call
+// rather than silently falling back to the reflective builder for that one field, since
+// resolvable.ExecutableSchema grafts a generated schema's root operations on whole with no
+// per-field mixing.
+func TestGenerate_ObjectRootFieldRejected(t *testing.T) {
+	s := schema.New()
+	if err := schema.Parse(s, objectRootFieldSDL, false); err != nil {
+		t.Fatalf("parsing schema: %v", err)
+	}
+
+	cfg := &Config{
+		Package: "gentest",
+		Models:  map[string]ModelConfig{"Query": {}},
+	}
+
+	if _, err := Generate(s, cfg); err == nil {
+		t.Fatal("expected Generate to reject a root field whose type is not a scalar or enum")
+	}
+}
+
+// TestGenerate_QueryOnlySchemaCompiles guards against a class of bug where the generated
+// ExecutableSchema.Query/Mutation/Subscription methods called a build<Op> method unconditionally,
+// even for a root operation type the schema doesn't define. A schema with no Mutation or
+// Subscription type — the common case — produced Go source with a call to an undefined method and
+// failed to compile.
+func TestGenerate_QueryOnlySchemaCompiles(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go toolchain not available")
+	}
+
+	s := schema.New()
+	if err := schema.Parse(s, queryOnlySDL, false); err != nil {
+		t.Fatalf("parsing schema: %v", err)
+	}
+
+	cfg := &Config{
+		Package: "gentest",
+		Models:  map[string]ModelConfig{"Query": {}},
+	}
+
+	src, err := Generate(s, cfg)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	// The package is created as a subdirectory of cmd/graphql-gen (rather than under the system
+	// temp dir) so its import path stays rooted under this module and it keeps the right to import
+	// the generated code's "internal/..." dependencies.
+	dir, err := os.MkdirTemp(".", "gentest-")
+	if err != nil {
+		t.Fatalf("creating temp package dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "exec.go"), src, 0o644); err != nil {
+		t.Fatalf("writing generated file: %v", err)
+	}
+
+	cmd := exec.Command("go", "build", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated code for a query-only schema does not compile:\n%s", out)
+	}
+}
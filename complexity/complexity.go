@@ -0,0 +1,48 @@
+// Package complexity implements a query complexity analyzer: a schema author registers a cost
+// function per field, and Validate rejects operations whose total computed cost exceeds a
+// configured maximum before any resolver runs.
+package complexity
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Root holds the cost functions registered for individual fields. Each function has the
+// signature `func(args T, childComplexity int) int`, where T is the same args struct type used by
+// the field's resolver method, or `func(childComplexity int) int` for a field with no arguments.
+type Root struct {
+	funcs map[string]reflect.Value
+}
+
+// NewRoot returns an empty Root ready to have cost functions registered on it.
+func NewRoot() *Root {
+	return &Root{funcs: make(map[string]reflect.Value)}
+}
+
+// Register sets the cost function for typeName.fieldName, overwriting any previous registration.
+// It panics if fn is not a func, since a bad registration is a programmer error caught at schema
+// construction time rather than a runtime condition.
+func (r *Root) Register(typeName, fieldName string, fn interface{}) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		panic(fmt.Sprintf("complexity: cost function for %q.%q must be a func, got %T", typeName, fieldName, fn))
+	}
+	r.funcs[key(typeName, fieldName)] = v
+}
+
+// Func returns the cost function registered for typeName.fieldName, if any. Its signature matches
+// resolvable.ComplexityFunc, so a *Root can be passed directly wherever that is expected:
+//
+//	resolvable.ApplyResolver(schema, resolver, dirVisitors, complexityRoot.Func, typeMap, useFieldResolvers)
+func (r *Root) Func(typeName, fieldName string) (reflect.Value, bool) {
+	if r == nil {
+		return reflect.Value{}, false
+	}
+	v, ok := r.funcs[key(typeName, fieldName)]
+	return v, ok
+}
+
+func key(typeName, fieldName string) string {
+	return typeName + "." + fieldName
+}
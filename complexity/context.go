@@ -0,0 +1,21 @@
+package complexity
+
+import "context"
+
+type contextKey int
+
+const complexityKey contextKey = 0
+
+// WithComplexity returns a copy of ctx carrying the complexity computed for the current
+// operation. The schema calls this before invoking its Tracer's TraceQuery, so a Tracer
+// implementation can read the value back out of the context it receives, e.g. from within its
+// QueryFinishFunc, to record it alongside the rest of its instrumentation.
+func WithComplexity(ctx context.Context, complexity int) context.Context {
+	return context.WithValue(ctx, complexityKey, complexity)
+}
+
+// FromContext returns the complexity computed for the operation running on ctx, if any.
+func FromContext(ctx context.Context) (int, bool) {
+	c, ok := ctx.Value(complexityKey).(int)
+	return c, ok
+}
@@ -0,0 +1,269 @@
+package complexity
+
+import (
+	"reflect"
+
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/internal/exec/resolvable"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+// Validate walks op's selection set bottom-up and computes its total complexity: a list field's
+// child complexity is multiplied by its `first`/`last` argument (or defaultListSize, when neither
+// is present), an interface or union field takes the max complexity across its possible concrete
+// types, fragment spreads and inline fragments are inlined into their parent selection set, and a
+// field's own cost is its registered function applied to its packed arguments and the sum of its
+// selection set's children, or 1+childComplexity when no function is registered.
+//
+// It returns the computed complexity alongside a query error when that complexity exceeds
+// maxComplexity (a maxComplexity of 0 disables the check). When the complexity cannot be computed
+// (a cost function's arguments cannot be packed, or a `first`/`last` argument is not a
+// non-negative integer) it returns 0 and a query error.
+func Validate(schema *resolvable.Schema, op *types.OperationDefinition, fragments types.FragmentList,
+	vars map[string]interface{}, maxComplexity, defaultListSize int) (int, *errors.QueryError) {
+	var root resolvable.Resolvable
+	switch string(op.Type) {
+	case "MUTATION":
+		root = schema.Mutation
+	case "SUBSCRIPTION":
+		root = schema.Subscription
+	default:
+		root = schema.Query
+	}
+
+	obj, ok := asObject(root)
+	if !ok {
+		return 0, nil
+	}
+
+	total, err := selectionSetComplexity(obj, op.Selections, fragments, vars, defaultListSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if maxComplexity > 0 && total > maxComplexity {
+		return total, errors.Errorf("operation has complexity %d, which exceeds the maximum allowed complexity of %d", total, maxComplexity)
+	}
+
+	return total, nil
+}
+
+func selectionSetComplexity(obj *resolvable.Object, sels types.SelectionSet, fragments types.FragmentList,
+	vars map[string]interface{}, defaultListSize int) (int, *errors.QueryError) {
+	var total int
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *types.Field:
+			f, ok := obj.Fields[sel.Name.Name]
+			if !ok {
+				// Unknown or introspection (__typename, __schema) field; query validation is
+				// responsible for rejecting the former, and the latter carries no registered cost.
+				continue
+			}
+
+			childComplexity, err := childComplexity(f, sel, fragments, vars, defaultListSize)
+			if err != nil {
+				return 0, err
+			}
+
+			if isListField(f) {
+				n, err := listSize(sel, vars, defaultListSize)
+				if err != nil {
+					return 0, err
+				}
+				childComplexity = saturatingMul(n, childComplexity)
+			}
+
+			cost, err := fieldCost(f, sel, childComplexity, vars)
+			if err != nil {
+				return 0, err
+			}
+			total = saturatingAdd(total, cost)
+
+		case *types.FragmentSpread:
+			fr := fragments.Get(sel.Name.Name)
+			if fr == nil {
+				continue
+			}
+			c, err := selectionSetComplexity(obj, fr.Selections, fragments, vars, defaultListSize)
+			if err != nil {
+				return 0, err
+			}
+			total = saturatingAdd(total, c)
+
+		case *types.InlineFragment:
+			target := obj
+			if sel.On.Name != "" {
+				if t, ok := possibleType(obj, sel.On.Name); ok {
+					target = t
+				}
+			}
+			c, err := selectionSetComplexity(target, sel.Selections, fragments, vars, defaultListSize)
+			if err != nil {
+				return 0, err
+			}
+			total = saturatingAdd(total, c)
+		}
+	}
+	return total, nil
+}
+
+// childComplexity computes the cost of sel's own selection set, for use as a field's
+// childComplexity argument. For an interface or union field it takes the max across every
+// possible concrete type, since the concrete type (and therefore its registered cost functions)
+// isn't known until resolution.
+func childComplexity(f *resolvable.Field, sel *types.Field, fragments types.FragmentList,
+	vars map[string]interface{}, defaultListSize int) (int, *errors.QueryError) {
+	obj, ok := asObject(f.ValueExec)
+	if !ok {
+		return 0, nil
+	}
+
+	if len(obj.TypeAssertions) == 0 {
+		return selectionSetComplexity(obj, sel.SelectionSet, fragments, vars, defaultListSize)
+	}
+
+	var max int
+	for _, ta := range obj.TypeAssertions {
+		concrete, ok := asObject(ta.TypeExec)
+		if !ok {
+			continue
+		}
+		c, err := selectionSetComplexity(concrete, sel.SelectionSet, fragments, vars, defaultListSize)
+		if err != nil {
+			return 0, err
+		}
+		if c > max {
+			max = c
+		}
+	}
+	return max, nil
+}
+
+func fieldCost(f *resolvable.Field, sel *types.Field, childComplexity int, vars map[string]interface{}) (int, *errors.QueryError) {
+	if !f.Complexity.IsValid() {
+		return saturatingAdd(1, childComplexity), nil
+	}
+
+	in := make([]reflect.Value, 0, 2)
+	if f.Complexity.Type().NumIn() == 2 {
+		args, err := packArgs(f, sel, vars)
+		if err != nil {
+			return 0, err
+		}
+		in = append(in, args)
+	}
+	in = append(in, reflect.ValueOf(childComplexity))
+
+	out := f.Complexity.Call(in)
+	return int(out[0].Int()), nil
+}
+
+func packArgs(f *resolvable.Field, sel *types.Field, vars map[string]interface{}) (reflect.Value, *errors.QueryError) {
+	if f.ArgsPacker == nil {
+		return reflect.Value{}, errors.Errorf("complexity: cost function for %q takes arguments, but the field defines none", f.Name)
+	}
+
+	values := make(map[string]interface{}, len(sel.Arguments))
+	for _, arg := range sel.Arguments {
+		values[arg.Name.Name] = arg.Value.Deserialize(vars)
+	}
+
+	packed, err := f.ArgsPacker.Pack(values)
+	if err != nil {
+		return reflect.Value{}, errors.Errorf("complexity: packing arguments for %q: %s", f.Name, err)
+	}
+	return packed, nil
+}
+
+func isListField(f *resolvable.Field) bool {
+	t := f.Type
+	if nn, ok := t.(*types.NonNull); ok {
+		t = nn.OfType
+	}
+	_, ok := t.(*types.List)
+	return ok
+}
+
+func listSize(sel *types.Field, vars map[string]interface{}, defaultListSize int) (int, *errors.QueryError) {
+	for _, name := range [...]string{"first", "last"} {
+		v, ok := sel.Arguments.Get(name)
+		if !ok {
+			continue
+		}
+		n, ok := toInt(v.Deserialize(vars))
+		if !ok {
+			return 0, errors.Errorf("complexity: %q argument on field %q is not an integer", name, sel.Name.Name)
+		}
+		if n < 0 {
+			return 0, errors.Errorf("complexity: %q argument on field %q is negative", name, sel.Name.Name)
+		}
+		return n, nil
+	}
+	return defaultListSize, nil
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case int:
+		return n, true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+// asObject unwraps List wrappers to find the underlying Object, if any; a Scalar (or enum) leaf
+// has no selection set and reports false.
+func asObject(r resolvable.Resolvable) (*resolvable.Object, bool) {
+	for {
+		switch v := r.(type) {
+		case *resolvable.List:
+			r = v.Elem
+		case *resolvable.Object:
+			return v, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+func possibleType(obj *resolvable.Object, name string) (*resolvable.Object, bool) {
+	ta, ok := obj.TypeAssertions[name]
+	if !ok {
+		return nil, false
+	}
+	return asObject(ta.TypeExec)
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// saturatingAdd and saturatingMul clamp to maxInt instead of wrapping, so a maliciously large
+// `first`/`last` argument or a deeply nested selection set can't overflow the running total into
+// a small or negative number and slip past the complexity check.
+func saturatingAdd(a, b int) int {
+	if a < 0 || b < 0 {
+		return maxInt
+	}
+	c := a + b
+	if c < a {
+		return maxInt
+	}
+	return c
+}
+
+func saturatingMul(a, b int) int {
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	c := a * b
+	if c/a != b {
+		return maxInt
+	}
+	return c
+}
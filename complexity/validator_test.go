@@ -0,0 +1,174 @@
+package complexity
+
+import (
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/internal/exec/resolvable"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+func field(name string, sub types.SelectionSet) *types.Field {
+	return &types.Field{
+		Name:         types.Ident{Name: name},
+		SelectionSet: sub,
+	}
+}
+
+func TestSaturatingAdd(t *testing.T) {
+	if got := saturatingAdd(2, 3); got != 5 {
+		t.Errorf("saturatingAdd(2, 3) = %d, want 5", got)
+	}
+	if got := saturatingAdd(maxInt, 1); got != maxInt {
+		t.Errorf("saturatingAdd(maxInt, 1) = %d, want maxInt (clamped, not wrapped)", got)
+	}
+	if got := saturatingAdd(maxInt/2+1, maxInt/2+1); got != maxInt {
+		t.Errorf("saturatingAdd near maxInt = %d, want maxInt (clamped, not wrapped into negative)", got)
+	}
+}
+
+func TestSaturatingMul(t *testing.T) {
+	if got := saturatingMul(4, 5); got != 20 {
+		t.Errorf("saturatingMul(4, 5) = %d, want 20", got)
+	}
+	if got := saturatingMul(maxInt, 2); got != maxInt {
+		t.Errorf("saturatingMul(maxInt, 2) = %d, want maxInt (clamped, not wrapped)", got)
+	}
+	if got := saturatingMul(0, 5); got != 0 {
+		t.Errorf("saturatingMul(0, 5) = %d, want 0", got)
+	}
+}
+
+func TestSelectionSetComplexity_DefaultCost(t *testing.T) {
+	obj := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"name": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+		},
+	}
+	sels := types.SelectionSet{field("name", nil)}
+
+	got, err := selectionSetComplexity(obj, sels, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("complexity = %d, want 1 (no cost function registered, leaf field)", got)
+	}
+}
+
+func TestSelectionSetComplexity_ListMultipliesByDefaultSize(t *testing.T) {
+	child := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"name": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+		},
+	}
+	obj := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"items": {
+				FieldDefinition: types.FieldDefinition{Type: &types.List{OfType: &types.ScalarTypeDefinition{Name: "Item"}}},
+				ValueExec:       &resolvable.List{Elem: child},
+			},
+		},
+	}
+	sels := types.SelectionSet{field("items", types.SelectionSet{field("name", nil)})}
+
+	got, err := selectionSetComplexity(obj, sels, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The child selection set costs 1 (a single leaf field with no registered cost function),
+	// multiplied by the defaultListSize of 10 since no first/last argument is present, plus 1 for
+	// the list field itself.
+	if want := 1 + 10*1; got != want {
+		t.Errorf("complexity = %d, want %d", got, want)
+	}
+}
+
+func TestChildComplexity_InterfaceTakesMaxAcrossPossibleTypes(t *testing.T) {
+	dog := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"bark": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+		},
+	}
+	cat := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"meow": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+			"purr": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+		},
+	}
+	animalField := &resolvable.Field{
+		ValueExec: &resolvable.Object{
+			TypeAssertions: map[string]*resolvable.TypeAssertion{
+				"Dog": {TypeExec: dog},
+				"Cat": {TypeExec: cat},
+			},
+		},
+	}
+	sel := field("animal", types.SelectionSet{
+		field("meow", nil),
+		field("purr", nil),
+	})
+
+	got, err := childComplexity(animalField, sel, nil, nil, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Dog has neither "meow" nor "purr", so its branch costs 0; Cat has both, costing 1+1=2. The
+	// concrete type isn't known until resolution, so childComplexity must take the max, not Dog's 0.
+	if got != 2 {
+		t.Errorf("complexity = %d, want 2 (max across possible types, not the first one)", got)
+	}
+}
+
+// fakeIntValue implements types.Value by returning a fixed int64, the same shape toInt accepts
+// for a deserialized "first"/"last" argument.
+type fakeIntValue int64
+
+func (v fakeIntValue) Deserialize(vars map[string]interface{}) interface{} { return int64(v) }
+
+func TestListSize_NegativeArgumentRejected(t *testing.T) {
+	sel := &types.Field{
+		Name:      types.Ident{Name: "items"},
+		Arguments: types.ArgumentList{{Name: types.Ident{Name: "first"}, Value: fakeIntValue(-1)}},
+	}
+
+	if _, err := listSize(sel, nil, 10); err == nil {
+		t.Fatal("a negative first/last argument should be rejected, not silently accepted for multiplication")
+	}
+}
+
+func TestListSize_PositiveArgumentAccepted(t *testing.T) {
+	sel := &types.Field{
+		Name:      types.Ident{Name: "items"},
+		Arguments: types.ArgumentList{{Name: types.Ident{Name: "first"}, Value: fakeIntValue(3)}},
+	}
+
+	n, err := listSize(sel, nil, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("listSize = %d, want 3", n)
+	}
+}
+
+func TestSelectionSetComplexity_FragmentSpreadInlines(t *testing.T) {
+	obj := &resolvable.Object{
+		Fields: map[string]*resolvable.Field{
+			"name": {FieldDefinition: types.FieldDefinition{Type: &types.ScalarTypeDefinition{Name: "String"}}},
+		},
+	}
+	fragments := types.FragmentList{
+		{Name: types.Ident{Name: "Frag"}, Selections: types.SelectionSet{field("name", nil)}},
+	}
+	sels := types.SelectionSet{
+		&types.FragmentSpread{Name: types.Ident{Name: "Frag"}},
+	}
+
+	got, err := selectionSetComplexity(obj, sels, fragments, nil, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 1 {
+		t.Errorf("complexity = %d, want 1 (fragment spread inlined into its parent selection set)", got)
+	}
+}
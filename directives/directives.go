@@ -0,0 +1,56 @@
+// Package directives defines the extension points through which a custom GraphQL directive can
+// hook into schema construction and query execution.
+package directives
+
+import "context"
+
+// Directive is implemented by every custom directive visitor. ImplementsDirective identifies the
+// directive's name as declared in the schema's `directive @name on LOCATION` definition.
+//
+// A visitor is only useful if it also implements one of the location-specific interceptor
+// interfaces below; ApplyResolver rejects a directive whose registered visitor implements none of
+// them.
+type Directive interface {
+	ImplementsDirective() string
+}
+
+// ResolverFunc invokes the next step in an interceptor chain, ultimately resolving to the value
+// that would have been produced had the directive not been present.
+type ResolverFunc func(ctx context.Context) (interface{}, error)
+
+// ResolverInterceptor wraps the resolution of a single field for a directive declared at
+// FIELD_DEFINITION.
+type ResolverInterceptor interface {
+	Directive
+	InterceptResolver(ctx context.Context, next ResolverFunc) (interface{}, error)
+}
+
+// ObjectInterceptor wraps the resolution of an entire object for a directive declared at OBJECT.
+// It runs once per object instance, around the resolution of every one of that object's fields.
+type ObjectInterceptor interface {
+	Directive
+	InterceptObject(ctx context.Context, next ResolverFunc) (interface{}, error)
+}
+
+// ArgumentInterceptor mutates a single argument's value before the field method is called, for a
+// directive declared at ARGUMENT_DEFINITION. The returned value replaces the packed argument.
+type ArgumentInterceptor interface {
+	Directive
+	InterceptArgument(ctx context.Context, value interface{}) (interface{}, error)
+}
+
+// InputFieldInterceptor mutates a single input object field's value, for a directive declared at
+// INPUT_FIELD_DEFINITION. It is applied wherever the owning input object is used, including
+// through nested input objects and list/non-null wrappers.
+type InputFieldInterceptor interface {
+	Directive
+	InterceptInputField(ctx context.Context, value interface{}) (interface{}, error)
+}
+
+// EnumValueVisitor is notified of an enum value that carries the directive, for a directive
+// declared at ENUM_VALUE. Enum values have no resolver to wrap, so the visitor is invoked once at
+// schema build time rather than per request.
+type EnumValueVisitor interface {
+	Directive
+	VisitEnumValue(typeName, value string) error
+}
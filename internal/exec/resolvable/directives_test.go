@@ -0,0 +1,97 @@
+package resolvable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graph-gophers/graphql-go/directives"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+type fakeResolverInterceptor struct{ name string }
+
+func (f fakeResolverInterceptor) ImplementsDirective() string { return f.name }
+
+func (f fakeResolverInterceptor) InterceptResolver(ctx context.Context, next directives.ResolverFunc) (interface{}, error) {
+	return next(ctx)
+}
+
+type fakeDirectiveOnly struct{ name string }
+
+func (f fakeDirectiveOnly) ImplementsDirective() string { return f.name }
+
+type countingEnumValueVisitor struct {
+	name  string
+	calls int
+}
+
+func (f *countingEnumValueVisitor) ImplementsDirective() string { return f.name }
+
+func (f *countingEnumValueVisitor) VisitEnumValue(typeName, value string) error {
+	f.calls++
+	return nil
+}
+
+func TestApplyDirectives_SchemaLocationToleratedWithoutVisitor(t *testing.T) {
+	s := &types.Schema{
+		Directives: map[string]*types.DirectiveDefinition{
+			"cache": {Locations: []string{"SCHEMA"}},
+		},
+	}
+	if _, err := applyDirectives(s, nil); err != nil {
+		t.Fatalf("directive declared `on SCHEMA` with no registered visitor must be tolerated, got error: %v", err)
+	}
+}
+
+func TestApplyDirectives_UnregisteredFieldDefinitionDirectiveErrors(t *testing.T) {
+	s := &types.Schema{
+		Directives: map[string]*types.DirectiveDefinition{
+			"auth": {Locations: []string{"FIELD_DEFINITION"}},
+		},
+	}
+	if _, err := applyDirectives(s, nil); err == nil {
+		t.Fatal("expected an error for a FIELD_DEFINITION directive with no registered visitor")
+	}
+}
+
+// TestVisitEnumValues_RunsOnceEvenAcrossDistinctTypeNodes guards against the same enum being
+// visited more than once when it's reached through more than one AST node (e.g. bound as both
+// Status! and Status on different fields): makeExec is memoized per (types.Type, resolverType), not
+// per enum name, and the NonNull wrapper here is a distinct *types.NonNull pointer each time, so
+// without visitedEnums this would retrigger the visitor.
+func TestVisitEnumValues_RunsOnceEvenAcrossDistinctTypeNodes(t *testing.T) {
+	visitor := &countingEnumValueVisitor{name: "cache"}
+	enumDef := &types.EnumTypeDefinition{
+		Name: "Status",
+		EnumValuesDefinition: []*types.EnumValueDefinition{
+			{EnumValue: "ACTIVE", Directives: types.DirectiveList{{Name: types.Ident{Name: "cache"}}}},
+		},
+	}
+
+	b := &execBuilder{
+		directives:   map[string]directives.Directive{"cache": visitor},
+		visitedEnums: make(map[string]bool),
+	}
+
+	if err := b.visitEnumValues(enumDef); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Simulate the enum being reached again through a different AST node (a distinct *NonNull
+	// wrapping the same *EnumTypeDefinition), as would happen for a second field using Status!.
+	if err := b.visitEnumValues(enumDef); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if visitor.calls != 1 {
+		t.Errorf("VisitEnumValue called %d times, want 1: visitEnumValues must run once per enum per schema", visitor.calls)
+	}
+}
+
+func TestImplementsAnyVisitor(t *testing.T) {
+	if !implementsAnyVisitor(fakeResolverInterceptor{name: "auth"}) {
+		t.Fatal("a ResolverInterceptor should satisfy implementsAnyVisitor")
+	}
+	if implementsAnyVisitor(fakeDirectiveOnly{name: "noop"}) {
+		t.Fatal("a Directive implementing no interceptor interface should not satisfy implementsAnyVisitor")
+	}
+}
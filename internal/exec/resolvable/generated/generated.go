@@ -0,0 +1,132 @@
+// Package generated provides the runtime support used by code emitted by cmd/graphql-gen. A
+// generated file builds a resolvable.Schema's Query, Mutation and Subscription trees ahead of
+// time, with every field's resolver wired up as a direct closure (resolvable.GeneratedFieldFunc)
+// instead of being discovered through reflection on every request. The emitted type then
+// implements resolvable.ExecutableSchema, which ApplyResolver recognises and grafts onto the
+// schema unchanged.
+package generated
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/graph-gophers/graphql-go/internal/exec/resolvable"
+)
+
+// NewObject builds a resolvable.Object whose fields were all constructed by NewField, for use in
+// the tree returned by a generated Query/Mutation/Subscription method.
+func NewObject(name string, fields map[string]*resolvable.Field, typeAssertions map[string]*resolvable.TypeAssertion) *resolvable.Object {
+	return &resolvable.Object{
+		Name:           name,
+		Fields:         fields,
+		TypeAssertions: typeAssertions,
+	}
+}
+
+// NewField builds a resolvable.Field driven entirely by resolve, with no MethodIndex/FieldIndex
+// for the executor to fall back to. argsPacker may be nil for a field with no arguments.
+func NewField(typeName, name string, argsPacker resolvable.Packer, resolve resolvable.GeneratedFieldFunc, valueExec resolvable.Resolvable) *resolvable.Field {
+	f := &resolvable.Field{
+		TypeName:    typeName,
+		MethodIndex: -1,
+		ArgsPacker:  argsPacker,
+		Resolve:     resolve,
+		ValueExec:   valueExec,
+		TraceLabel:  "GraphQL field: " + typeName + "." + name,
+	}
+	f.Name = name
+	return f
+}
+
+// NewTypeAssertion builds a resolvable.TypeAssertion for a possible type of an interface or union
+// field, pointing typeExec at the concrete object's own generated tree. Generated code has no use
+// for reflective dispatch through a To<Name> method, so MethodIndex is left unset; the caller
+// supplies its own assert in the Resolve closure that built this field instead.
+func NewTypeAssertion(typeExec resolvable.Resolvable) *resolvable.TypeAssertion {
+	return &resolvable.TypeAssertion{MethodIndex: -1, TypeExec: typeExec}
+}
+
+// List wraps elem as the element executor of a resolvable.List.
+func List(elem resolvable.Resolvable) *resolvable.List {
+	return &resolvable.List{Elem: elem}
+}
+
+// Scalar returns a new leaf executor for a scalar or enum field.
+func Scalar() *resolvable.Scalar {
+	return &resolvable.Scalar{}
+}
+
+// DecodeFunc unpacks a field's raw argument map into the concrete args value the field's
+// generated Resolve closure expects.
+type DecodeFunc func(value interface{}) (reflect.Value, error)
+
+// StructDecoder adapts a DecodeFunc emitted by cmd/graphql-gen to resolvable.Packer. A generated
+// decoder type-switches/asserts its way through the raw map[string]interface{} directly, so the
+// args struct it produces never goes through reflect.Value.Set at request time.
+type StructDecoder struct {
+	Decode DecodeFunc
+}
+
+func (d StructDecoder) Pack(value interface{}) (reflect.Value, error) {
+	return d.Decode(value)
+}
+
+// CoerceInt32, CoerceFloat64, CoerceString and CoerceBool coerce a single deserialized argument
+// value to the Go type a generated field expects, the same conversions packer.ValuePacker applies
+// reflectively for the builtin scalars. They are called directly from a generated DecodeFunc, one
+// per scalar argument, instead of going through reflection.
+func CoerceInt32(v interface{}) (int32, error) {
+	switch n := v.(type) {
+	case int32:
+		return n, nil
+	case float64:
+		return int32(n), nil
+	default:
+		return 0, fmt.Errorf("not an Int: %T", v)
+	}
+}
+
+func CoerceFloat64(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case int32:
+		return float64(n), nil
+	default:
+		return 0, fmt.Errorf("not a Float: %T", v)
+	}
+}
+
+func CoerceString(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("not a String: %T", v)
+	}
+	return s, nil
+}
+
+func CoerceBool(v interface{}) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("not a Boolean: %T", v)
+	}
+	return b, nil
+}
+
+// CoerceSlice applies elem to every item of v, which must be a []interface{}, building the typed
+// slice a generated list argument expects.
+func CoerceSlice[T any](v interface{}, elem func(interface{}) (T, error)) ([]T, error) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not a list: %T", v)
+	}
+	out := make([]T, len(list))
+	for i, item := range list {
+		c, err := elem(item)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		out[i] = c
+	}
+	return out, nil
+}
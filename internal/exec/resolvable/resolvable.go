@@ -2,6 +2,7 @@ package resolvable
 
 import (
 	"context"
+	"encoding"
 	"fmt"
 	"reflect"
 	"strings"
@@ -27,6 +28,10 @@ type Schema struct {
 	QueryResolver        reflect.Value
 	MutationResolver     reflect.Value
 	SubscriptionResolver reflect.Value
+	// InputFieldInterceptors holds the INPUT_FIELD_DEFINITION directive bindings collected for
+	// every input object reachable from an argument type, keyed by input object name and then
+	// field name.
+	InputFieldInterceptors map[string]map[string][]*InputFieldInterceptorBinding
 }
 
 type Resolvable interface {
@@ -34,22 +39,70 @@ type Resolvable interface {
 }
 
 type Object struct {
-	Name           string
-	Fields         map[string]*Field
-	TypeAssertions map[string]*TypeAssertion
+	Name               string
+	Fields             map[string]*Field
+	TypeAssertions     map[string]*TypeAssertion
+	ObjectInterceptors []*ObjectInterceptorBinding
+	// Dispatch reports which of TypeAssertions a resolved value represents, for an interface or
+	// union bound through TypeMap.Dispatch. It is nil for a plain object, and for an interface or
+	// union that relies on the default To<Name> method assertion instead.
+	Dispatch DispatchFunc
+}
+
+// ObjectInterceptorBinding pairs a registered OBJECT directive visitor with the packer needed to
+// unpack that directive's own arguments.
+type ObjectInterceptorBinding struct {
+	Directive directives.ObjectInterceptor
+	Packer    *packer.StructPacker
 }
 
 type Field struct {
 	types.FieldDefinition
-	TypeName          string
-	MethodIndex       int
-	FieldIndex        []int
-	HasContext        bool
-	HasError          bool
-	ArgsPacker        *packer.StructPacker
-	DirectivesPackers map[string]*packer.StructPacker
-	ValueExec         Resolvable
-	TraceLabel        string
+	TypeName             string
+	MethodIndex          int
+	FieldIndex           []int
+	HasContext           bool
+	HasError             bool
+	ArgsPacker           Packer
+	DirectivesPackers    map[string]*packer.StructPacker
+	ArgumentInterceptors map[string][]*ArgumentInterceptorBinding
+	// Complexity is the cost function registered for this field via a ComplexityFunc, with
+	// signature func(args T, childComplexity int) int (or func(childComplexity int) int for a
+	// field with no arguments). It is the zero Value when no cost function is registered.
+	Complexity reflect.Value
+	// Resolve is set on a field generated by cmd/graphql-gen: a closure over the field's concrete
+	// resolver and argument types that the executor calls directly instead of reflecting into the
+	// resolver through MethodIndex/FieldIndex. It is nil for every field built by the reflective
+	// builder below.
+	Resolve    GeneratedFieldFunc
+	ValueExec  Resolvable
+	TraceLabel string
+}
+
+// Packer unpacks a field's raw (already-deserialized) argument map into the reflect.Value a
+// resolver expects. *packer.StructPacker, built reflectively by execBuilder, implements it; so
+// does a struct decoder emitted by cmd/graphql-gen for a field whose Resolve is set.
+type Packer interface {
+	Pack(value interface{}) (reflect.Value, error)
+}
+
+// GeneratedFieldFunc is a field executor emitted by cmd/graphql-gen. dirs holds the packed
+// arguments for any ResolverInterceptor directives on the field, keyed by directive name, the
+// same values a reflective executor would pass to directives.ResolverInterceptor.InterceptResolver.
+type GeneratedFieldFunc func(ctx context.Context, resolver interface{}, args interface{}, dirs map[string]interface{}) (interface{}, error)
+
+// ArgumentInterceptorBinding pairs a registered ARGUMENT_DEFINITION directive visitor with the
+// packer needed to unpack that directive's own arguments.
+type ArgumentInterceptorBinding struct {
+	Directive directives.ArgumentInterceptor
+	Packer    *packer.StructPacker
+}
+
+// InputFieldInterceptorBinding pairs a registered INPUT_FIELD_DEFINITION directive visitor with
+// the packer needed to unpack that directive's own arguments.
+type InputFieldInterceptorBinding struct {
+	Directive directives.InputFieldInterceptor
+	Packer    *packer.StructPacker
 }
 
 func (f *Field) UseMethodResolver() bool {
@@ -71,17 +124,122 @@ func (*Object) isResolvable() {}
 func (*List) isResolvable()   {}
 func (*Scalar) isResolvable() {}
 
-func ApplyResolver(s *types.Schema, resolver interface{}, dirVisitors []directives.Directive, useFieldResolvers bool) (*Schema, error) {
+// ComplexityFunc looks up the cost function registered for a field, keyed by the name of the type
+// that owns it and the field's own name. A nil ComplexityFunc, or one that reports no match,
+// leaves Field.Complexity as the zero Value.
+type ComplexityFunc func(typeName, fieldName string) (reflect.Value, bool)
+
+// validateComplexityFunc checks fn's signature against what complexity.Validate will actually call
+// it with: func(args argsType, childComplexity int) int when the field takes arguments, or
+// func(childComplexity int) int when argsType is nil. Without this check, a mismatched arity,
+// argument type or return type would panic via reflect.Value.Call the first time a client sent a
+// matching query, rather than failing fast here at schema construction like every other reflective
+// binding in this file.
+func validateComplexityFunc(fn reflect.Value, argsType reflect.Type) error {
+	t := fn.Type()
+	if t.Kind() != reflect.Func {
+		return fmt.Errorf("expected a function, got %s", t)
+	}
+
+	wantIn := 1
+	if argsType != nil {
+		wantIn = 2
+	}
+	if t.NumIn() != wantIn {
+		if argsType != nil {
+			return fmt.Errorf("expected signature func(args %s, childComplexity int) int", argsType)
+		}
+		return fmt.Errorf("expected signature func(childComplexity int) int")
+	}
+	if argsType != nil && t.In(0) != argsType {
+		return fmt.Errorf("expected first parameter of type %s, got %s", argsType, t.In(0))
+	}
+	if t.In(wantIn-1) != reflect.TypeOf(0) {
+		return fmt.Errorf("expected childComplexity parameter of type int, got %s", t.In(wantIn-1))
+	}
+	if t.NumOut() != 1 || t.Out(0).Kind() != reflect.Int {
+		return fmt.Errorf("expected a single int return value")
+	}
+	return nil
+}
+
+// TypeMap lets a schema author bind GraphQL types to Go types explicitly, analogous to gqlgen's
+// binder config, for the cases the default reflection-based matching in makeScalarExec and
+// makeObjectExec can't cover on its own. A nil TypeMap, or a zero-value field within one, leaves
+// the corresponding reflection-based behavior unchanged.
+type TypeMap struct {
+	// Scalars binds a named scalar whose Go representation doesn't implement decode.Unmarshaler
+	// (time.Time, decimal.Decimal, uuid.UUID, or a scalar.ID[T] whose T varies per field) to a
+	// marshal/unmarshal function pair, keyed by scalar name.
+	Scalars map[string]*ScalarBinding
+	// Enums overrides the Go type bound to a named enum, keyed by enum name. The bound type must be
+	// string-kind or implement encoding.TextMarshaler.
+	//
+	// Unlike the string/TextMarshaler check above, this package does not verify that the bound type
+	// can actually produce every value the SDL enum declares: reflect.Type has no general way to
+	// enumerate "all values a type can take" the way it can check Kind() or an interface. Verifying
+	// that exhaustively is a code-generation-time concern (walking the SDL's EnumValuesDefinition
+	// against, say, a generated Go constant per value) rather than something makeExec can check at
+	// schema-build time against an arbitrary bound reflect.Type; it is out of scope here.
+	Enums map[string]reflect.Type
+	// Objects overrides the Go type bound to a named object type, keyed by object name, used in
+	// place of a To<Name> method's return type when that object is a possible type of an interface
+	// or union that has a Dispatch func registered for it.
+	Objects map[string]reflect.Type
+	// Dispatch resolves which of an interface or union's possible types a runtime value represents,
+	// keyed by the interface or union's own name. When set for a type, makeObjectExec uses it in
+	// place of reflectively asserting a To<Name> method on the resolver for every possible type, and
+	// every one of that type's possible types must have a matching entry in Objects.
+	Dispatch map[string]DispatchFunc
+}
+
+// DispatchFunc reports the name of the possible type resolver represents, for the interface or
+// union it was registered against, by name, in TypeMap.Dispatch.
+type DispatchFunc func(resolver interface{}) (typeName string, ok bool)
+
+// ScalarBinding marshals and unmarshals a scalar whose Go representation has no
+// ImplementsGraphQLType/UnmarshalGraphQL methods of its own.
+type ScalarBinding struct {
+	GoType    reflect.Type
+	Marshal   func(v interface{}) (interface{}, error)
+	Unmarshal func(input interface{}) (interface{}, error)
+}
+
+// ExecutableSchema is implemented by a resolver generated ahead of time by cmd/graphql-gen. When
+// the resolver passed to ApplyResolver satisfies it, ApplyResolver grafts its pre-built Query,
+// Mutation and Subscription trees directly onto the returned Schema instead of building them
+// reflectively via makeExec/makeObjectExec. Directive binding, complexity registration and
+// useFieldResolvers have no effect on a generated schema; they are the generator's responsibility.
+type ExecutableSchema interface {
+	Query() Resolvable
+	Mutation() Resolvable
+	Subscription() Resolvable
+}
+
+func ApplyResolver(s *types.Schema, resolver interface{}, dirVisitors []directives.Directive, complexity ComplexityFunc, typeMap *TypeMap, useFieldResolvers bool) (*Schema, error) {
 	if resolver == nil {
 		return &Schema{Meta: newMeta(s), Schema: *s}, nil
 	}
 
+	if es, ok := resolver.(ExecutableSchema); ok {
+		return &Schema{
+			Meta:                 newMeta(s),
+			Schema:               *s,
+			QueryResolver:        reflect.ValueOf(resolver),
+			MutationResolver:     reflect.ValueOf(resolver),
+			SubscriptionResolver: reflect.ValueOf(resolver),
+			Query:                es.Query(),
+			Mutation:             es.Mutation(),
+			Subscription:         es.Subscription(),
+		}, nil
+	}
+
 	ds, err := applyDirectives(s, dirVisitors)
 	if err != nil {
 		return nil, err
 	}
 
-	b := newBuilder(s, ds, useFieldResolvers)
+	b := newBuilder(s, ds, complexity, typeMap, useFieldResolvers)
 
 	var query, mutation, subscription Resolvable
 
@@ -147,14 +305,15 @@ func ApplyResolver(s *types.Schema, resolver interface{}, dirVisitors []directiv
 	}
 
 	return &Schema{
-		Meta:                 newMeta(s),
-		Schema:               *s,
-		QueryResolver:        reflect.ValueOf(resolvers[Query]),
-		MutationResolver:     reflect.ValueOf(resolvers[Mutation]),
-		SubscriptionResolver: reflect.ValueOf(resolvers[Subscription]),
-		Query:                query,
-		Mutation:             mutation,
-		Subscription:         subscription,
+		Meta:                   newMeta(s),
+		Schema:                 *s,
+		QueryResolver:          reflect.ValueOf(resolvers[Query]),
+		MutationResolver:       reflect.ValueOf(resolvers[Mutation]),
+		SubscriptionResolver:   reflect.ValueOf(resolvers[Subscription]),
+		Query:                  query,
+		Mutation:               mutation,
+		Subscription:           subscription,
+		InputFieldInterceptors: b.inputFieldInterceptors,
 	}, nil
 }
 
@@ -169,8 +328,7 @@ func applyDirectives(s *types.Schema, visitors []directives.Directive) (map[stri
 		}
 
 		// At least 1 of the optional directive functions must be defined for each directive.
-		// For now this is the only valid directive function
-		if _, ok := v.(directives.ResolverInterceptor); !ok {
+		if !implementsAnyVisitor(v) {
 			return nil, fmt.Errorf("directive %q (implemented by %T) does not implement a valid directive visitor function", name, v)
 		}
 
@@ -178,12 +336,9 @@ func applyDirectives(s *types.Schema, visitors []directives.Directive) (map[stri
 	}
 
 	for name, def := range s.Directives {
-		// TODO: directives other than FIELD_DEFINITION also need to be supported, and later addition of
-		// capabilities to 'visit' other kinds of directive locations shouldn't break the parsing of existing
-		// schemas that declare those directives, but don't have a visitor for them?
 		var acceptedType bool
 		for _, l := range def.Locations {
-			if l == "FIELD_DEFINITION" {
+			if _, ok := acceptedDirectiveLocations[l]; ok {
 				acceptedType = true
 				break
 			}
@@ -206,12 +361,90 @@ func applyDirectives(s *types.Schema, visitors []directives.Directive) (map[stri
 	return byName, nil
 }
 
+// acceptedDirectiveLocations are the directive locations a visitor may be registered for. Any
+// other location is tolerated without a visitor, as every location other than FIELD_DEFINITION
+// was before this allowlist grew past it. SCHEMA is deliberately absent:
+// no visitor interface exists for it yet, so requiring one registered would break every existing
+// schema that declares a directive `on SCHEMA` without one.
+var acceptedDirectiveLocations = map[string]struct{}{
+	"FIELD_DEFINITION":       {},
+	"OBJECT":                 {},
+	"ARGUMENT_DEFINITION":    {},
+	"INPUT_FIELD_DEFINITION": {},
+	"ENUM_VALUE":             {},
+}
+
+// implementsAnyVisitor reports whether v implements at least one of the directive visitor
+// interfaces recognised at any location.
+func implementsAnyVisitor(v directives.Directive) bool {
+	switch v.(type) {
+	case directives.ResolverInterceptor, directives.ObjectInterceptor, directives.ArgumentInterceptor,
+		directives.InputFieldInterceptor, directives.EnumValueVisitor:
+		return true
+	default:
+		return false
+	}
+}
+
+// bindDirectives scans dirs for directives whose registered visitor satisfies assert, building a
+// packer for each matching directive's own arguments the same way a field's arguments are packed.
+// A directive present in dirs but registered for a different location is silently skipped here;
+// applyDirectives already guaranteed that every directive in the schema has a visitor for at
+// least one location.
+func bindDirectives[V directives.Directive, B any](b *execBuilder, dirs types.DirectiveList,
+	assert func(directives.Directive) (V, bool), wrap func(V, *packer.StructPacker) *B) ([]*B, error) {
+	var out []*B
+	for _, d := range dirs {
+		n := d.Name.Name
+		if n == "include" || n == "skip" || n == "deprecated" || n == "specifiedBy" {
+			continue
+		}
+
+		v, ok := b.directives[n]
+		if !ok {
+			continue
+		}
+
+		tv, ok := assert(v)
+		if !ok {
+			// Directive doesn't apply at this location, skip it.
+			continue
+		}
+
+		dd, ok := b.schema.Directives[n]
+		if !ok {
+			return nil, fmt.Errorf("directive definition %q is not defined in the schema", n)
+		}
+		p, err := b.packerBuilder.MakeStructPacker(dd.Arguments, reflect.TypeOf(v))
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, wrap(tv, p))
+	}
+	return out, nil
+}
+
 type execBuilder struct {
 	schema            *types.Schema
 	resMap            map[typePair]*resMapEntry
 	directives        map[string]directives.Directive
+	complexity        ComplexityFunc
+	typeMap           *TypeMap
 	packerBuilder     *packer.Builder
 	useFieldResolvers bool
+	// inputFieldInterceptors accumulates the INPUT_FIELD_DEFINITION bindings discovered while
+	// walking argument types, keyed by input object name and then field name.
+	inputFieldInterceptors map[string]map[string][]*InputFieldInterceptorBinding
+	// visitedInputObjects guards against revisiting (and re-erroring on) an input object that is
+	// reachable through more than one argument or nesting path.
+	visitedInputObjects map[string]bool
+	// visitedEnums guards against re-running ENUM_VALUE visitors on an enum that's reachable through
+	// more than one field type (e.g. used as both NonNull and nullable, or on more than one field):
+	// resMap only memoizes makeExec per (types.Type, resolverType) pair, and the AST node for a
+	// field's type is a distinct pointer per field declaration, so without this the same enum would
+	// be visited once per occurrence instead of once per schema.
+	visitedEnums map[string]bool
 }
 
 type typePair struct {
@@ -224,13 +457,18 @@ type resMapEntry struct {
 	targets []*Resolvable
 }
 
-func newBuilder(s *types.Schema, directives map[string]directives.Directive, useFieldResolvers bool) *execBuilder {
+func newBuilder(s *types.Schema, directives map[string]directives.Directive, complexity ComplexityFunc, typeMap *TypeMap, useFieldResolvers bool) *execBuilder {
 	return &execBuilder{
-		schema:            s,
-		resMap:            make(map[typePair]*resMapEntry),
-		directives:        directives,
-		packerBuilder:     packer.NewBuilder(),
-		useFieldResolvers: useFieldResolvers,
+		schema:                 s,
+		resMap:                 make(map[typePair]*resMapEntry),
+		directives:             directives,
+		complexity:             complexity,
+		typeMap:                typeMap,
+		packerBuilder:          packer.NewBuilder(),
+		useFieldResolvers:      useFieldResolvers,
+		inputFieldInterceptors: make(map[string]map[string][]*InputFieldInterceptorBinding),
+		visitedInputObjects:    make(map[string]bool),
+		visitedEnums:           make(map[string]bool),
 	}
 }
 
@@ -266,13 +504,13 @@ func (b *execBuilder) makeExec(t types.Type, resolverType reflect.Type) (Resolva
 
 	switch t := t.(type) {
 	case *types.ObjectTypeDefinition:
-		return b.makeObjectExec(t.Name, t.Fields, nil, nonNull, resolverType)
+		return b.makeObjectExec(t.Name, t.Fields, nil, t.Directives, nonNull, resolverType)
 
 	case *types.InterfaceTypeDefinition:
-		return b.makeObjectExec(t.Name, t.Fields, t.PossibleTypes, nonNull, resolverType)
+		return b.makeObjectExec(t.Name, t.Fields, t.PossibleTypes, nil, nonNull, resolverType)
 
 	case *types.Union:
-		return b.makeObjectExec(t.Name, nil, t.UnionMemberTypes, nonNull, resolverType)
+		return b.makeObjectExec(t.Name, nil, t.UnionMemberTypes, nil, nonNull, resolverType)
 	}
 
 	if !nonNull {
@@ -284,9 +522,20 @@ func (b *execBuilder) makeExec(t types.Type, resolverType reflect.Type) (Resolva
 
 	switch t := t.(type) {
 	case *types.ScalarTypeDefinition:
-		return makeScalarExec(t, resolverType)
+		return b.makeScalarExec(t, resolverType)
 
 	case *types.EnumTypeDefinition:
+		if err := b.visitEnumValues(t); err != nil {
+			return nil, err
+		}
+		if bound, ok := b.typeMap.enum(t.Name); ok {
+			if resolverType != bound {
+				return nil, fmt.Errorf("can not use %s as %s: bound to %s by TypeMap", resolverType, t.Name, bound)
+			}
+			if bound.Kind() != reflect.String && !implementsTextMarshaler(bound) {
+				return nil, fmt.Errorf("enum %s is bound to %s by TypeMap, which is neither string-kind nor encoding.TextMarshaler", t.Name, bound)
+			}
+		}
 		return &Scalar{}, nil
 
 	case *types.List:
@@ -304,7 +553,47 @@ func (b *execBuilder) makeExec(t types.Type, resolverType reflect.Type) (Resolva
 	}
 }
 
-func makeScalarExec(t *types.ScalarTypeDefinition, resolverType reflect.Type) (Resolvable, error) {
+// visitEnumValues notifies every registered ENUM_VALUE visitor of the values it was declared on.
+// Enum values have no resolver to wrap, so visitors run once here at schema build time rather than
+// per request. makeExec is keyed by (types.Type, resolverType), not by enum name, so the same enum
+// reached through a different NonNull/List wrapper or a different field would otherwise retrigger
+// this; visitedEnums makes the "once" contract hold regardless of how many times the enum is used.
+func (b *execBuilder) visitEnumValues(t *types.EnumTypeDefinition) error {
+	if b.visitedEnums[t.Name] {
+		return nil
+	}
+	b.visitedEnums[t.Name] = true
+
+	for _, ev := range t.EnumValuesDefinition {
+		for _, d := range ev.Directives {
+			n := d.Name.Name
+			if n == "deprecated" {
+				continue
+			}
+			v, ok := b.directives[n]
+			if !ok {
+				continue
+			}
+			visitor, ok := v.(directives.EnumValueVisitor)
+			if !ok {
+				continue
+			}
+			if err := visitor.VisitEnumValue(t.Name, ev.EnumValue); err != nil {
+				return fmt.Errorf("directive %q on enum value %s.%s: %s", n, t.Name, ev.EnumValue, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *execBuilder) makeScalarExec(t *types.ScalarTypeDefinition, resolverType reflect.Type) (Resolvable, error) {
+	if binding, ok := b.typeMap.scalar(t.Name); ok {
+		if resolverType != binding.GoType {
+			return nil, fmt.Errorf("can not use %s as %s: bound to %s by TypeMap", resolverType, t.Name, binding.GoType)
+		}
+		return &Scalar{}, nil
+	}
+
 	implementsType := false
 	switch r := reflect.New(resolverType).Interface().(type) {
 	case *int32:
@@ -325,8 +614,48 @@ func makeScalarExec(t *types.ScalarTypeDefinition, resolverType reflect.Type) (R
 	return &Scalar{}, nil
 }
 
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+func implementsTextMarshaler(t reflect.Type) bool {
+	return t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)
+}
+
+// enum, scalar, object and dispatch look up a binding in tm, tolerating a nil TypeMap (or a nil
+// map field within one) the same way an empty map would.
+func (tm *TypeMap) enum(name string) (reflect.Type, bool) {
+	if tm == nil {
+		return nil, false
+	}
+	t, ok := tm.Enums[name]
+	return t, ok
+}
+
+func (tm *TypeMap) scalar(name string) (*ScalarBinding, bool) {
+	if tm == nil {
+		return nil, false
+	}
+	b, ok := tm.Scalars[name]
+	return b, ok
+}
+
+func (tm *TypeMap) object(name string) (reflect.Type, bool) {
+	if tm == nil {
+		return nil, false
+	}
+	t, ok := tm.Objects[name]
+	return t, ok
+}
+
+func (tm *TypeMap) dispatch(name string) (DispatchFunc, bool) {
+	if tm == nil {
+		return nil, false
+	}
+	fn, ok := tm.Dispatch[name]
+	return fn, ok
+}
+
 func (b *execBuilder) makeObjectExec(typeName string, fields types.FieldsDefinition, possibleTypes []*types.ObjectTypeDefinition,
-	nonNull bool, resolverType reflect.Type) (*Object, error) {
+	dirs types.DirectiveList, nonNull bool, resolverType reflect.Type) (*Object, error) {
 	if !nonNull {
 		if resolverType.Kind() != reflect.Ptr && resolverType.Kind() != reflect.Interface {
 			return nil, fmt.Errorf("%s is not a pointer or interface", resolverType)
@@ -379,7 +708,20 @@ func (b *execBuilder) makeObjectExec(typeName string, fields types.FieldsDefinit
 	//	1) using method resolvers
 	//	2) Or resolver is not an interface type
 	typeAssertions := make(map[string]*TypeAssertion)
-	if !b.useFieldResolvers || resolverType.Kind() != reflect.Interface {
+	dispatch, hasDispatch := b.typeMap.dispatch(typeName)
+	if hasDispatch {
+		for _, impl := range possibleTypes {
+			implType, ok := b.typeMap.object(impl.Name)
+			if !ok {
+				return nil, fmt.Errorf("%q has a Dispatch func registered for %q, but no TypeMap.Objects binding for possible type %q", typeName, typeName, impl.Name)
+			}
+			a := &TypeAssertion{MethodIndex: -1}
+			if err := b.assignExec(&a.TypeExec, impl, implType); err != nil {
+				return nil, err
+			}
+			typeAssertions[impl.Name] = a
+		}
+	} else if !b.useFieldResolvers || resolverType.Kind() != reflect.Interface {
 		for _, impl := range possibleTypes {
 			methodIndex := findMethod(resolverType, "To"+impl.Name)
 			if methodIndex == -1 {
@@ -406,10 +748,22 @@ func (b *execBuilder) makeObjectExec(typeName string, fields types.FieldsDefinit
 		}
 	}
 
+	objectInterceptors, err := bindDirectives(b, dirs, func(d directives.Directive) (directives.ObjectInterceptor, bool) {
+		v, ok := d.(directives.ObjectInterceptor)
+		return v, ok
+	}, func(d directives.ObjectInterceptor, p *packer.StructPacker) *ObjectInterceptorBinding {
+		return &ObjectInterceptorBinding{Directive: d, Packer: p}
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &Object{
-		Name:           typeName,
-		Fields:         Fields,
-		TypeAssertions: typeAssertions,
+		Name:               typeName,
+		Fields:             Fields,
+		Dispatch:           dispatch,
+		TypeAssertions:     typeAssertions,
+		ObjectInterceptors: objectInterceptors,
 	}, nil
 }
 
@@ -420,6 +774,7 @@ func (b *execBuilder) makeFieldExec(typeName string, f *types.FieldDefinition, m
 	methodIndex int, fieldIndex []int, methodHasReceiver bool) (*Field, error) {
 
 	var argsPacker *packer.StructPacker
+	var argsType reflect.Type
 	var hasError bool
 	var hasContext bool
 
@@ -443,6 +798,7 @@ func (b *execBuilder) makeFieldExec(typeName string, f *types.FieldDefinition, m
 				return nil, fmt.Errorf("must have `args struct { ... }` argument for field arguments")
 			}
 			var err error
+			argsType = in[0]
 			argsPacker, err = b.packerBuilder.MakeStructPacker(f.Arguments, in[0])
 			if err != nil {
 				return nil, err
@@ -506,16 +862,51 @@ func (b *execBuilder) makeFieldExec(typeName string, f *types.FieldDefinition, m
 		directivesPackers[n] = p
 	}
 
+	argumentInterceptors := map[string][]*ArgumentInterceptorBinding{}
+	for _, arg := range f.Arguments {
+		bindings, err := bindDirectives(b, arg.Directives, func(d directives.Directive) (directives.ArgumentInterceptor, bool) {
+			v, ok := d.(directives.ArgumentInterceptor)
+			return v, ok
+		}, func(d directives.ArgumentInterceptor, p *packer.StructPacker) *ArgumentInterceptorBinding {
+			return &ArgumentInterceptorBinding{Directive: d, Packer: p}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(bindings) > 0 {
+			argumentInterceptors[arg.Name.Name] = bindings
+		}
+
+		if err := b.collectInputFieldInterceptors(arg.Type); err != nil {
+			return nil, err
+		}
+	}
+
 	fe := &Field{
-		FieldDefinition:   *f,
-		TypeName:          typeName,
-		MethodIndex:       methodIndex,
-		FieldIndex:        fieldIndex,
-		HasContext:        hasContext,
-		ArgsPacker:        argsPacker,
-		DirectivesPackers: directivesPackers,
-		HasError:          hasError,
-		TraceLabel:        fmt.Sprintf("GraphQL field: %s.%s", typeName, f.Name),
+		FieldDefinition:      *f,
+		TypeName:             typeName,
+		MethodIndex:          methodIndex,
+		FieldIndex:           fieldIndex,
+		HasContext:           hasContext,
+		DirectivesPackers:    directivesPackers,
+		ArgumentInterceptors: argumentInterceptors,
+		HasError:             hasError,
+		TraceLabel:           fmt.Sprintf("GraphQL field: %s.%s", typeName, f.Name),
+	}
+	// Assigned only when non-nil: argsPacker is a typed *packer.StructPacker, and storing a nil one
+	// directly in the Packer interface field would make fe.ArgsPacker != nil even though nothing
+	// was packed.
+	if argsPacker != nil {
+		fe.ArgsPacker = argsPacker
+	}
+
+	if b.complexity != nil {
+		if fn, ok := b.complexity(typeName, f.Name); ok {
+			if err := validateComplexityFunc(fn, argsType); err != nil {
+				return nil, fmt.Errorf("invalid complexity function for %s.%s: %s", typeName, f.Name, err)
+			}
+			fe.Complexity = fn
+		}
 	}
 
 	var out reflect.Type
@@ -535,6 +926,50 @@ func (b *execBuilder) makeFieldExec(typeName string, f *types.FieldDefinition, m
 	return fe, nil
 }
 
+// collectInputFieldInterceptors walks t, unwrapping NonNull and List wrappers, and records the
+// INPUT_FIELD_DEFINITION bindings for every input object field reachable from it. Nested input
+// objects are visited recursively; each input object is only visited once per schema.
+func (b *execBuilder) collectInputFieldInterceptors(t types.Type) error {
+	t, _ = unwrapNonNull(t)
+	if l, ok := t.(*types.List); ok {
+		return b.collectInputFieldInterceptors(l.OfType)
+	}
+
+	io, ok := t.(*types.InputObject)
+	if !ok {
+		return nil
+	}
+	if b.visitedInputObjects[io.Name] {
+		return nil
+	}
+	b.visitedInputObjects[io.Name] = true
+
+	fields := map[string][]*InputFieldInterceptorBinding{}
+	for _, v := range io.Values {
+		bindings, err := bindDirectives(b, v.Directives, func(d directives.Directive) (directives.InputFieldInterceptor, bool) {
+			iv, ok := d.(directives.InputFieldInterceptor)
+			return iv, ok
+		}, func(d directives.InputFieldInterceptor, p *packer.StructPacker) *InputFieldInterceptorBinding {
+			return &InputFieldInterceptorBinding{Directive: d, Packer: p}
+		})
+		if err != nil {
+			return err
+		}
+		if len(bindings) > 0 {
+			fields[v.Name.Name] = bindings
+		}
+
+		if err := b.collectInputFieldInterceptors(v.Type); err != nil {
+			return err
+		}
+	}
+	if len(fields) > 0 {
+		b.inputFieldInterceptors[io.Name] = fields
+	}
+
+	return nil
+}
+
 func findMethod(t reflect.Type, name string) int {
 	for i := 0; i < t.NumMethod(); i++ {
 		if strings.EqualFold(stripUnderscore(name), stripUnderscore(t.Method(i).Name)) {
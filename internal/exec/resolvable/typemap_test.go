@@ -0,0 +1,194 @@
+package resolvable
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+func TestTypeMapLookups_NilTypeMap(t *testing.T) {
+	var tm *TypeMap
+	if _, ok := tm.scalar("Time"); ok {
+		t.Error("scalar lookup on a nil TypeMap should report no binding")
+	}
+	if _, ok := tm.enum("Status"); ok {
+		t.Error("enum lookup on a nil TypeMap should report no binding")
+	}
+	if _, ok := tm.object("Person"); ok {
+		t.Error("object lookup on a nil TypeMap should report no binding")
+	}
+	if _, ok := tm.dispatch("Character"); ok {
+		t.Error("dispatch lookup on a nil TypeMap should report no binding")
+	}
+}
+
+func TestTypeMapLookups_Populated(t *testing.T) {
+	binding := &ScalarBinding{GoType: reflect.TypeOf(time.Time{})}
+	enumType := reflect.TypeOf(testStatus(""))
+	objType := reflect.TypeOf(&testPerson{})
+	dispatchFn := func(resolver interface{}) (string, bool) { return "", false }
+
+	tm := &TypeMap{
+		Scalars:  map[string]*ScalarBinding{"Time": binding},
+		Enums:    map[string]reflect.Type{"Status": enumType},
+		Objects:  map[string]reflect.Type{"Person": objType},
+		Dispatch: map[string]DispatchFunc{"Character": dispatchFn},
+	}
+
+	if b, ok := tm.scalar("Time"); !ok || b != binding {
+		t.Errorf("scalar(%q) = %v, %v; want %v, true", "Time", b, ok, binding)
+	}
+	if _, ok := tm.scalar("Duration"); ok {
+		t.Error("scalar lookup for an unbound name should report no binding")
+	}
+	if typ, ok := tm.enum("Status"); !ok || typ != enumType {
+		t.Errorf("enum(%q) = %v, %v; want %v, true", "Status", typ, ok, enumType)
+	}
+	if typ, ok := tm.object("Person"); !ok || typ != objType {
+		t.Errorf("object(%q) = %v, %v; want %v, true", "Person", typ, ok, objType)
+	}
+	if _, ok := tm.dispatch("Character"); !ok {
+		t.Error("dispatch lookup for a bound name should report the binding")
+	}
+}
+
+type testStatus string
+
+type testPerson struct{}
+
+func TestMakeScalarExec_TypeMapBinding(t *testing.T) {
+	b := &execBuilder{
+		typeMap: &TypeMap{
+			Scalars: map[string]*ScalarBinding{
+				"Time": {GoType: reflect.TypeOf(time.Time{})},
+			},
+		},
+	}
+	scalarDef := &types.ScalarTypeDefinition{Name: "Time"}
+
+	if _, err := b.makeScalarExec(scalarDef, reflect.TypeOf(time.Time{})); err != nil {
+		t.Errorf("resolver type matching the TypeMap binding should be accepted, got error: %v", err)
+	}
+	if _, err := b.makeScalarExec(scalarDef, reflect.TypeOf("")); err == nil {
+		t.Error("resolver type not matching the TypeMap binding should be rejected")
+	}
+}
+
+func TestMakeExec_EnumTypeMapBinding(t *testing.T) {
+	b := &execBuilder{
+		typeMap: &TypeMap{
+			Enums: map[string]reflect.Type{"Status": reflect.TypeOf(testStatus(""))},
+		},
+		visitedEnums: make(map[string]bool),
+	}
+	enumDef := &types.NonNull{OfType: &types.EnumTypeDefinition{Name: "Status"}}
+
+	if _, err := b.makeExec(enumDef, reflect.TypeOf(testStatus(""))); err != nil {
+		t.Errorf("resolver type matching the TypeMap enum binding should be accepted, got error: %v", err)
+	}
+	if _, err := b.makeExec(enumDef, reflect.TypeOf("")); err == nil {
+		t.Error("resolver type not matching the TypeMap enum binding should be rejected, even though string implements no interface here")
+	}
+}
+
+type testArgs struct{ Limit int32 }
+
+func TestValidateComplexityFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       interface{}
+		argsType reflect.Type
+		wantErr  bool
+	}{
+		{
+			name:    "valid, no args",
+			fn:      func(childComplexity int) int { return childComplexity },
+			wantErr: false,
+		},
+		{
+			name:     "valid, with args",
+			fn:       func(args testArgs, childComplexity int) int { return childComplexity },
+			argsType: reflect.TypeOf(testArgs{}),
+			wantErr:  false,
+		},
+		{
+			name:    "not a function",
+			fn:      42,
+			wantErr: true,
+		},
+		{
+			name:    "wrong arity for no-args field",
+			fn:      func(a, b int) int { return a + b },
+			wantErr: true,
+		},
+		{
+			name:     "args type mismatch",
+			fn:       func(args string, childComplexity int) int { return childComplexity },
+			argsType: reflect.TypeOf(testArgs{}),
+			wantErr:  true,
+		},
+		{
+			name:    "childComplexity not an int",
+			fn:      func(childComplexity string) int { return 0 },
+			wantErr: true,
+		},
+		{
+			name:    "wrong return type",
+			fn:      func(childComplexity int) string { return "" },
+			wantErr: true,
+		},
+		{
+			name:    "too many return values",
+			fn:      func(childComplexity int) (int, error) { return 0, nil },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComplexityFunc(reflect.ValueOf(tt.fn), tt.argsType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateComplexityFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMakeObjectExec_Dispatch(t *testing.T) {
+	person := &types.ObjectTypeDefinition{Name: "Person"}
+	dispatchFn := func(resolver interface{}) (string, bool) { return "Person", true }
+
+	t.Run("bound possible type succeeds", func(t *testing.T) {
+		b := &execBuilder{
+			resMap: make(map[typePair]*resMapEntry),
+			typeMap: &TypeMap{
+				Dispatch: map[string]DispatchFunc{"Character": dispatchFn},
+				Objects:  map[string]reflect.Type{"Person": reflect.TypeOf(&testPerson{})},
+			},
+		}
+		obj, err := b.makeObjectExec("Character", nil, []*types.ObjectTypeDefinition{person}, nil, true, reflect.TypeOf(struct{}{}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if obj.Dispatch == nil {
+			t.Error("Object.Dispatch should be set from TypeMap.Dispatch")
+		}
+		if _, ok := obj.TypeAssertions["Person"]; !ok {
+			t.Error("expected a TypeAssertion for the Dispatch-resolved possible type \"Person\"")
+		}
+	})
+
+	t.Run("missing Objects binding fails", func(t *testing.T) {
+		b := &execBuilder{
+			resMap: make(map[typePair]*resMapEntry),
+			typeMap: &TypeMap{
+				Dispatch: map[string]DispatchFunc{"Character": dispatchFn},
+			},
+		}
+		if _, err := b.makeObjectExec("Character", nil, []*types.ObjectTypeDefinition{person}, nil, true, reflect.TypeOf(struct{}{})); err == nil {
+			t.Error("a Dispatch func with no matching TypeMap.Objects binding for a possible type should error")
+		}
+	})
+}